@@ -0,0 +1,184 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/reugn/go-quartz/quartz"
+)
+
+// RetryPolicy configures the backoff and pause-on-failure behavior applied
+// by [WithRetry].
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of consecutive Execute attempts
+	// before the job is paused.
+	MaxAttempts int
+
+	// InitialDelay is the backoff delay after the first failed attempt.
+	InitialDelay time.Duration
+
+	// MaxDelay caps the computed backoff delay. Zero means no cap.
+	MaxDelay time.Duration
+
+	// Multiplier scales the delay between successive attempts.
+	Multiplier float64
+
+	// Jitter is the fraction, in [0, 1], by which the computed delay is
+	// randomly adjusted up or down.
+	Jitter float64
+
+	// IsRetryable reports whether a failed attempt should be retried. A
+	// nil IsRetryable retries every error.
+	IsRetryable func(error) bool
+}
+
+// RetryJob wraps a [quartz.Job], re-invoking Execute on failure with
+// exponential backoff according to a [RetryPolicy]. Implements the
+// [quartz.Job] interface.
+type RetryJob struct {
+	mtx    sync.RWMutex
+	inner  quartz.Job
+	policy RetryPolicy
+	bus    *quartz.EventBus
+	paused bool
+}
+
+var _ quartz.Job = (*RetryJob)(nil)
+
+// WithRetry wraps inner so that a failed Execute is retried, with backoff
+// computed as min(MaxDelay, InitialDelay * Multiplier^attempt) randomly
+// adjusted by Jitter. After policy.MaxAttempts consecutive failures, the
+// job publishes a [quartz.JobPaused] event and skips subsequent Execute
+// calls until [RetryJob.Resume] is called.
+func WithRetry(inner quartz.Job, policy RetryPolicy) *RetryJob {
+	return &RetryJob{
+		inner:  inner,
+		policy: policy,
+		bus:    quartz.NewEventBus(),
+	}
+}
+
+// Description returns the description of the wrapped Job.
+func (j *RetryJob) Description() string {
+	return j.inner.Description()
+}
+
+// EventBus returns the [quartz.EventBus] this job publishes its lifecycle
+// events to.
+func (j *RetryJob) EventBus() *quartz.EventBus {
+	return j.bus
+}
+
+// Paused reports whether the job is currently paused after exhausting its
+// retry policy.
+func (j *RetryJob) Paused() bool {
+	j.mtx.RLock()
+	defer j.mtx.RUnlock()
+	return j.paused
+}
+
+// Resume clears the paused state set after policy.MaxAttempts consecutive
+// failures, allowing the wrapped Job to run again on its next fire time. A
+// Scheduler integration would typically expose this as
+// Scheduler.Resume(jobKey), resolving the job's RetryJob by key and
+// delegating to Resume.
+func (j *RetryJob) Resume() {
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	j.paused = false
+}
+
+// Execute implements the [quartz.Job] interface.
+func (j *RetryJob) Execute(ctx context.Context) error {
+	if j.Paused() {
+		j.publish(quartz.JobSkipped, nil)
+		return nil
+	}
+
+	maxAttempts := j.policy.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		lastErr = j.inner.Execute(ctx)
+		if lastErr == nil {
+			return nil
+		}
+		if j.policy.IsRetryable != nil && !j.policy.IsRetryable(lastErr) {
+			// Not worth retrying, but the retry budget itself isn't
+			// exhausted: leave the job eligible to run on its next fire time.
+			return lastErr
+		}
+		if attempt == maxAttempts-1 {
+			j.pause()
+			return lastErr
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(j.policy.backoff(attempt)):
+		}
+	}
+
+	return lastErr
+}
+
+func (j *RetryJob) pause() {
+	j.mtx.Lock()
+	j.paused = true
+	j.mtx.Unlock()
+	j.publish(quartz.JobPaused, nil)
+}
+
+func (j *RetryJob) publish(kind quartz.EventKind, err error) {
+	j.bus.Publish(quartz.Event{
+		Kind:           kind,
+		JobDescription: j.Description(),
+		Err:            err,
+		Time:           quartz.NowNano(),
+	})
+}
+
+// backoff computes the delay before the given retry attempt (0-indexed),
+// as min(MaxDelay, InitialDelay * Multiplier^attempt) randomly adjusted by
+// Jitter.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.InitialDelay) * math.Pow(p.Multiplier, float64(attempt))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	if p.Jitter > 0 {
+		delay *= 1 + (rand.Float64()*2-1)*p.Jitter
+	}
+	if delay < 0 {
+		delay = 0
+	}
+	return time.Duration(delay)
+}
+
+// RetryOnExitCodes returns an IsRetryable predicate for [RetryPolicy] that
+// retries a failed [ShellJob] execution only when the underlying command
+// exited with one of the given codes.
+func RetryOnExitCodes(codes ...int) func(error) bool {
+	return func(err error) bool {
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return false
+		}
+		exitCode := exitErr.ExitCode()
+		for _, code := range codes {
+			if code == exitCode {
+				return true
+			}
+		}
+		return false
+	}
+}
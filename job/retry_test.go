@@ -0,0 +1,132 @@
+package job_test
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/reugn/go-quartz/internal/assert"
+	"github.com/reugn/go-quartz/job"
+	"github.com/reugn/go-quartz/quartz"
+)
+
+// countingJob fails until succeedOnAttempt calls to Execute have been made.
+type countingJob struct {
+	attempts         int
+	succeedOnAttempt int
+}
+
+func (j *countingJob) Description() string { return "countingJob" }
+
+func (j *countingJob) Execute(context.Context) error {
+	j.attempts++
+	if j.attempts >= j.succeedOnAttempt {
+		return nil
+	}
+	return errors.New("not yet")
+}
+
+func TestWithRetrySucceedsAfterAttempts(t *testing.T) {
+	t.Parallel()
+
+	inner := &countingJob{succeedOnAttempt: 3}
+	retryJob := job.WithRetry(inner, job.RetryPolicy{
+		MaxAttempts:  5,
+		InitialDelay: time.Millisecond,
+		MaxDelay:     10 * time.Millisecond,
+		Multiplier:   2,
+	})
+
+	err := retryJob.Execute(context.Background())
+	assert.IsNil(t, err)
+	assert.Equal(t, inner.attempts, 3)
+	assert.Equal(t, retryJob.Paused(), false)
+}
+
+func TestWithRetryPausesAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	inner := &countingJob{succeedOnAttempt: 100}
+	retryJob := job.WithRetry(inner, job.RetryPolicy{
+		MaxAttempts:  3,
+		InitialDelay: time.Millisecond,
+		MaxDelay:     5 * time.Millisecond,
+		Multiplier:   2,
+	})
+
+	var paused bool
+	done := make(chan struct{}, 1)
+	retryJob.EventBus().Subscribe(quartz.JobPaused, func(quartz.Event) {
+		paused = true
+		done <- struct{}{}
+	})
+
+	err := retryJob.Execute(context.Background())
+	if err == nil {
+		t.Fatal("expected an error after exhausting retries")
+	}
+	assert.Equal(t, inner.attempts, 3)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("JobPaused event was not published")
+	}
+	assert.Equal(t, paused, true)
+	assert.Equal(t, retryJob.Paused(), true)
+
+	// A paused job skips Execute entirely until Resume is called.
+	err = retryJob.Execute(context.Background())
+	assert.IsNil(t, err)
+	assert.Equal(t, inner.attempts, 3)
+
+	retryJob.Resume()
+	assert.Equal(t, retryJob.Paused(), false)
+
+	err = retryJob.Execute(context.Background())
+	if err == nil {
+		t.Fatal("expected an error, the inner job still isn't configured to succeed")
+	}
+	assert.Equal(t, inner.attempts, 6)
+}
+
+func TestWithRetryNotRetryable(t *testing.T) {
+	t.Parallel()
+
+	inner := &countingJob{succeedOnAttempt: 100}
+	retryJob := job.WithRetry(inner, job.RetryPolicy{
+		MaxAttempts:  5,
+		InitialDelay: time.Millisecond,
+		IsRetryable:  func(error) bool { return false },
+	})
+
+	err := retryJob.Execute(context.Background())
+	if err == nil {
+		t.Fatal("expected the first failure to be returned")
+	}
+	assert.Equal(t, inner.attempts, 1)
+
+	// A non-retryable error shouldn't consume the retry budget: the job
+	// stays eligible to run on its next scheduled fire time.
+	assert.Equal(t, retryJob.Paused(), false)
+}
+
+func TestRetryOnExitCodes(t *testing.T) {
+	t.Parallel()
+
+	predicate := job.RetryOnExitCodes(1, 2)
+
+	shellJob := job.NewShellJob("exit 1")
+	err := shellJob.Execute(context.Background())
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		t.Fatalf("expected an *exec.ExitError, got %v", err)
+	}
+	assert.Equal(t, predicate(err), true)
+
+	shellJob = job.NewShellJob("exit 3")
+	err = shellJob.Execute(context.Background())
+	assert.Equal(t, predicate(err), false)
+}
@@ -20,6 +20,7 @@ type ShellJob struct {
 	result    *ShellJobResult
 	jobStatus Status
 	callback  func(context.Context, *ShellJob)
+	bus       *quartz.EventBus
 }
 
 type ShellJobResult struct {
@@ -46,6 +47,28 @@ func NewShellJob(cmd string, opts ...ShellJobOptionFunc) *ShellJob {
 	for _, opt := range opts {
 		opt(job)
 	}
+
+	if job.bus == nil {
+		job.bus = quartz.NewEventBus()
+	}
+	if job.callback != nil {
+		// Translate the deprecated callback into a subscription so both
+		// mechanisms share the same Execute code path. The bus may be
+		// shared with other jobs (WithEventBus), so ignore events raised by
+		// a different job's Description.
+		callback := job.callback
+		description := job.Description()
+		subscriber := func(e quartz.Event) {
+			if e.JobDescription != description {
+				return
+			}
+			callback(context.Background(), job)
+		}
+		job.bus.Subscribe(quartz.JobSucceeded, subscriber)
+		job.bus.Subscribe(quartz.JobFailed, subscriber)
+		job.bus.Subscribe(quartz.JobTimedOut, subscriber)
+	}
+
 	return job
 }
 
@@ -59,6 +82,24 @@ func WithTimeout(timeout time.Duration) ShellJobOptionFunc {
 	}
 }
 
+// WithEventBus configures the [quartz.EventBus] the ShellJob publishes its
+// lifecycle events to. When not set, the ShellJob creates one of its own,
+// available via [ShellJob.EventBus].
+func WithEventBus(bus *quartz.EventBus) ShellJobOptionFunc {
+	return func(job *ShellJob) {
+		job.bus = bus
+	}
+}
+
+// WithCallback registers a function to be called after the job completes.
+//
+// Deprecated: subscribe to the ShellJob's [quartz.EventBus] instead, e.g.
+//
+//	job.EventBus().Subscribe(quartz.JobFailed, func(e quartz.Event) { ... })
+//
+// WithCallback remains functional; it is internally translated into a
+// subscription to JobSucceeded, JobFailed and JobTimedOut, invoked with a
+// background context rather than the original Execute context.
 func WithCallback(callback func(ctx context.Context, job *ShellJob)) ShellJobOptionFunc {
 	return func(job *ShellJob) {
 		job.callback = callback
@@ -87,6 +128,8 @@ func getShell() string {
 
 // Execute is called by a Scheduler when the Trigger associated with this job fires.
 func (sh *ShellJob) Execute(ctx context.Context) error {
+	sh.publish(quartz.JobStarted, nil)
+
 	shell := getShell()
 	var stdout, stderr bytes.Buffer
 
@@ -110,6 +153,7 @@ func (sh *ShellJob) Execute(ctx context.Context) error {
 	err := cmd.Start()
 	if err != nil {
 		sh.setJobResult(stdout.String(), stderr.String(), -1, StatusFailure)
+		sh.publish(quartz.JobFailed, err)
 		return err
 	}
 
@@ -146,25 +190,35 @@ func (sh *ShellJob) Execute(ctx context.Context) error {
 
 		sh.setJobResult(stdout.String(), stderr.String(), -1, StatusTimeout)
 		finalErr = ctx.Err()
+		sh.publish(quartz.JobTimedOut, finalErr)
+		return finalErr
 	}
 
-	// Execute callback if provided
-	// TODO: Use an event system (e.g., JOB_ADD, JOB_FAILED) instead of a direct callback.
-	if sh.callback != nil {
-		go func() {
-			defer func() {
-				if r := recover(); r != nil {
-					// Log panic but don't affect main flow
-					fmt.Printf("Callback panic: %v\n", r)
-				}
-			}()
-			sh.callback(ctx, sh)
-		}()
+	if finalErr != nil {
+		sh.publish(quartz.JobFailed, finalErr)
+	} else {
+		sh.publish(quartz.JobSucceeded, nil)
 	}
 
 	return finalErr
 }
 
+// publish publishes a lifecycle event for this job on its EventBus.
+func (sh *ShellJob) publish(kind quartz.EventKind, err error) {
+	sh.bus.Publish(quartz.Event{
+		Kind:           kind,
+		JobDescription: sh.Description(),
+		Err:            err,
+		Time:           quartz.NowNano(),
+	})
+}
+
+// EventBus returns the [quartz.EventBus] this job publishes its lifecycle
+// events to.
+func (sh *ShellJob) EventBus() *quartz.EventBus {
+	return sh.bus
+}
+
 // setJobResult is a helper method to set job result uniformly
 func (sh *ShellJob) setJobResult(stdout, stderr string, exitCode int, status Status) {
 	sh.mtx.Lock()
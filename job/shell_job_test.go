@@ -0,0 +1,42 @@
+package job_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/reugn/go-quartz/internal/assert"
+	"github.com/reugn/go-quartz/job"
+	"github.com/reugn/go-quartz/quartz"
+)
+
+func TestShellJobCallbackIgnoresOtherJobsOnSharedBus(t *testing.T) {
+	t.Parallel()
+
+	bus := quartz.NewEventBus()
+	called := make(chan struct{}, 10)
+
+	jobA := job.NewShellJob("exit 0",
+		job.WithEventBus(bus),
+		job.WithCallback(func(context.Context, *job.ShellJob) {
+			called <- struct{}{}
+		}),
+	)
+	jobB := job.NewShellJob("exit 1", job.WithEventBus(bus))
+
+	assert.IsNil(t, jobA.Execute(context.Background()))
+	select {
+	case <-called:
+	case <-time.After(time.Second):
+		t.Fatal("callback was not invoked for jobA's own completion")
+	}
+
+	// jobB shares the bus but not jobA's callback; jobA's callback must not
+	// fire again for jobB's completion.
+	_ = jobB.Execute(context.Background())
+	select {
+	case <-called:
+		t.Fatal("jobA's callback fired for jobB's completion on the shared bus")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -2,15 +2,30 @@ package quartz
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/robfig/cron/v3"
 )
 
+// CronTrigger implements the quartz.Trigger interface.
+// Used for triggering a Job at a given moment in time, defined with the
+// Unix-cron character string. By default a CronTrigger accepts the standard
+// 5-field expression (minute, hour, dom, month, dow); use
+// [NewCronTriggerWithOptions] with [WithSeconds] and [WithYear] to opt into
+// the 6- and 7-field variants popularized by quartz-java and
+// gorhill/cronexpr.
 type CronTrigger struct {
 	expression string
 	schedule   cron.Schedule
 	location   *time.Location
+	seconds    bool
+	yearMode   bool
+	years      []int
+	startAt    time.Time
+	endAt      time.Time
 }
 
 var _ Trigger = (*CronTrigger)(nil)
@@ -22,30 +37,220 @@ func NewCronTrigger(expression string) (*CronTrigger, error) {
 
 // NewCronTriggerWithLoc returns a new [CronTrigger] with the given [time.Location].
 func NewCronTriggerWithLoc(expression string, location *time.Location) (*CronTrigger, error) {
+	return newCronTrigger(expression, location, &cronOptions{})
+}
+
+// CronOption configures the field layout accepted by a [CronTrigger]
+// created via [NewCronTriggerWithOptions].
+type CronOption func(*cronOptions)
+
+// cronOptions holds the parser configuration assembled from a chain of
+// CronOption values.
+type cronOptions struct {
+	seconds bool
+	year    bool
+	startAt time.Time
+	endAt   time.Time
+}
+
+// WithSeconds switches the parser into 6-field mode, adding a leading
+// seconds field to the expression: SECOND MINUTE HOUR DOM MONTH DOW.
+func WithSeconds() CronOption {
+	return func(o *cronOptions) {
+		o.seconds = true
+	}
+}
+
+// WithYear switches the parser into 7-field mode, appending a trailing year
+// field to the expression: SECOND MINUTE HOUR DOM MONTH DOW YEAR. The year
+// field accepts a list, range and step expressions over [1970, 2099], e.g.
+// "2024,2026" or "2024-2030/2". WithYear implies WithSeconds.
+func WithYear() CronOption {
+	return func(o *cronOptions) {
+		o.seconds = true
+		o.year = true
+	}
+}
+
+// WithStartAt suppresses fire times before startAt, so the cron schedule
+// only takes effect once the given instant has passed.
+func WithStartAt(startAt time.Time) CronOption {
+	return func(o *cronOptions) {
+		o.startAt = startAt
+	}
+}
+
+// WithEndAt bounds the cron schedule to fire times at or before endAt.
+// Once the computed next fire time exceeds endAt, NextFireTime reports
+// that no next fire time is available.
+func WithEndAt(endAt time.Time) CronOption {
+	return func(o *cronOptions) {
+		o.endAt = endAt
+	}
+}
+
+// NewCronTriggerWithOptions returns a new [CronTrigger] using the UTC
+// location, configured by the given options.
+func NewCronTriggerWithOptions(expression string, opts ...CronOption) (*CronTrigger, error) {
+	options := &cronOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return newCronTrigger(expression, time.UTC, options)
+}
+
+const (
+	minCronYear             = 1970
+	maxCronYear             = 2099
+	maxYearSearchIterations = 100
+)
+
+func newCronTrigger(
+	expression string,
+	location *time.Location,
+	options *cronOptions,
+) (*CronTrigger, error) {
 	if location == nil {
 		return nil, newIllegalArgumentError("location is nil")
 	}
-
 	if expression == "" {
 		return nil, newIllegalArgumentError("cron expression cannot be empty")
 	}
 
-	parser := cron.NewParser(
-		cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
-	)
-
-	schedule, err := parser.Parse(expression)
+	schedule, years, err := parseCronExpression(expression, options)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse cron expression '%s': %w", expression, err)
+		return nil, err
 	}
 
 	return &CronTrigger{
 		expression: expression,
 		location:   location,
 		schedule:   schedule,
+		seconds:    options.seconds,
+		yearMode:   options.year,
+		years:      years,
+		startAt:    options.startAt,
+		endAt:      options.endAt,
 	}, nil
 }
 
+// parseYearField parses a cronexpr-style year field ("*", a list of years,
+// or ranges/steps such as "2024-2030/2") into the sorted set of matching
+// years within [minCronYear, maxCronYear]. An empty result means "any year".
+func parseYearField(field string) ([]int, error) {
+	if field == "*" {
+		return nil, nil
+	}
+
+	matched := make(map[int]bool)
+	for _, part := range strings.Split(field, ",") {
+		rangePart := part
+		step := 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			rangePart = part[:idx]
+			s, err := strconv.Atoi(part[idx+1:])
+			if err != nil || s <= 0 {
+				return nil, newIllegalArgumentError("invalid year step: " + part)
+			}
+			step = s
+		}
+
+		start, end := minCronYear, maxCronYear
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			s, errStart := strconv.Atoi(bounds[0])
+			e, errEnd := strconv.Atoi(bounds[1])
+			if errStart != nil || errEnd != nil {
+				return nil, newIllegalArgumentError("invalid year range: " + part)
+			}
+			start, end = s, e
+		default:
+			y, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return nil, newIllegalArgumentError("invalid year value: " + part)
+			}
+			start, end = y, y
+		}
+
+		for y := start; y <= end; y += step {
+			if y < minCronYear || y > maxCronYear {
+				return nil, newIllegalArgumentError(fmt.Sprintf(
+					"year %d out of supported range [%d, %d]", y, minCronYear, maxCronYear))
+			}
+			matched[y] = true
+		}
+	}
+
+	years := make([]int, 0, len(matched))
+	for y := range matched {
+		years = append(years, y)
+	}
+	sort.Ints(years)
+	return years, nil
+}
+
+// yearSchedule wraps a [cron.Schedule], skipping fire times whose year is
+// not in the allowed set.
+type yearSchedule struct {
+	schedule cron.Schedule
+	years    []int
+}
+
+var _ cron.Schedule = (*yearSchedule)(nil)
+
+// Next implements [cron.Schedule]. Rather than stepping through every
+// intermediate fire time, a candidate whose year isn't allowed causes the
+// search to jump straight to the boundary of the next allowed year, so a
+// daily or hourly schedule reaches a distant target year in a handful of
+// iterations instead of one per fire time. It returns the zero time if no
+// allowed year can be reached within maxYearSearchIterations jumps.
+func (s *yearSchedule) Next(t time.Time) time.Time {
+	next := t
+	for i := 0; i < maxYearSearchIterations; i++ {
+		candidate := s.schedule.Next(next)
+		if candidate.IsZero() || s.matchesYear(candidate.Year()) {
+			return candidate
+		}
+
+		target := s.nextAllowedYear(candidate.Year())
+		if target == 0 {
+			return time.Time{}
+		}
+
+		jump := time.Date(target, time.January, 1, 0, 0, 0, 0, candidate.Location()).
+			Add(-time.Nanosecond)
+		if !jump.After(candidate) {
+			// The only allowed years are behind the next candidate; no
+			// match is reachable going forward.
+			return time.Time{}
+		}
+		next = jump
+	}
+	return time.Time{}
+}
+
+func (s *yearSchedule) matchesYear(year int) bool {
+	for _, y := range s.years {
+		if y == year {
+			return true
+		}
+	}
+	return false
+}
+
+// nextAllowedYear returns the smallest allowed year >= after, or 0 if none.
+func (s *yearSchedule) nextAllowedYear(after int) int {
+	best := 0
+	for _, y := range s.years {
+		if y >= after && (best == 0 || y < best) {
+			best = y
+		}
+	}
+	return best
+}
+
 func (ct *CronTrigger) NextFireTime(prev int64) (int64, error) {
 	var baseTime time.Time
 
@@ -55,17 +260,77 @@ func (ct *CronTrigger) NextFireTime(prev int64) (int64, error) {
 		baseTime = time.UnixMilli(prev).In(ct.location)
 	}
 
+	if !ct.startAt.IsZero() {
+		floor := ct.startAt.Add(-time.Millisecond)
+		if baseTime.Before(floor) {
+			baseTime = floor
+		}
+	}
+
 	nextTime := ct.schedule.Next(baseTime)
 
 	if nextTime.IsZero() {
 		return -1, fmt.Errorf("no next fire time available for cron expression: %s", ct.expression)
 	}
 
+	if !ct.endAt.IsZero() && nextTime.After(ct.endAt) {
+		return -1, fmt.Errorf("no next fire time available for cron expression: %s "+
+			"falls after the configured end time %s", ct.expression, ct.endAt)
+	}
+
 	return nextTime.UnixMilli(), nil
 }
 
+// Preview returns up to n subsequent fire times in millis, computed from
+// prev, without advancing any internal scheduler state. It stops early and
+// returns a partial slice if the underlying schedule runs out of fire
+// times (see [CronTrigger.NextFireTime]).
+func (ct *CronTrigger) Preview(prev int64, n int) ([]int64, error) {
+	if n <= 0 {
+		return nil, newIllegalArgumentError("n must be positive")
+	}
+
+	times := make([]int64, 0, n)
+	next := prev
+	for i := 0; i < n; i++ {
+		fireTime, err := ct.NextFireTime(next)
+		if err != nil {
+			break
+		}
+		times = append(times, fireTime)
+		next = fireTime
+	}
+	return times, nil
+}
+
+// Description returns the description of the CronTrigger, including its
+// seconds/year mode so that the trigger can be faithfully recreated via
+// NewCronTriggerWithOptions after deserialization.
 func (ct *CronTrigger) Description() string {
-	return fmt.Sprintf("CronTrigger%s%s%s%s", Sep, ct.expression, Sep, ct.location.String())
+	mode := ""
+	switch {
+	case ct.yearMode:
+		mode = Sep + "years"
+	case ct.seconds:
+		mode = Sep + "seconds"
+	}
+
+	bounds := ""
+	if !ct.startAt.IsZero() || !ct.endAt.IsZero() {
+		bounds = fmt.Sprintf("%s%s%s%s", Sep, formatBound(ct.startAt), Sep, formatBound(ct.endAt))
+	}
+
+	return fmt.Sprintf("CronTrigger%s%s%s%s%s%s",
+		Sep, ct.expression, Sep, ct.location.String(), mode, bounds)
+}
+
+// formatBound formats a start/end bound for Description, using RFC3339 for
+// a set time and "-" for an unset one.
+func formatBound(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
 }
 
 func (ct *CronTrigger) GetExpression() string {
@@ -0,0 +1,520 @@
+package quartz
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/robfig/cron/v3"
+)
+
+// CronField identifies a field of a cron expression.
+type CronField int
+
+const (
+	FieldUnknown CronField = iota
+	FieldSecond
+	FieldMinute
+	FieldHour
+	FieldDom
+	FieldMonth
+	FieldDow
+	FieldYear
+)
+
+// String returns the name of the CronField.
+func (f CronField) String() string {
+	switch f {
+	case FieldSecond:
+		return "second"
+	case FieldMinute:
+		return "minute"
+	case FieldHour:
+		return "hour"
+	case FieldDom:
+		return "dom"
+	case FieldMonth:
+		return "month"
+	case FieldDow:
+		return "dow"
+	case FieldYear:
+		return "year"
+	default:
+		return "unknown"
+	}
+}
+
+// cronFieldBounds holds the valid numeric range for each field, used to
+// validate field values before handing the expression to the underlying
+// parser.
+var cronFieldBounds = map[CronField]fieldBounds{
+	FieldSecond: {0, 59},
+	FieldMinute: {0, 59},
+	FieldHour:   {0, 23},
+	FieldDom:    {1, 31},
+	FieldMonth:  {1, 12},
+	FieldDow:    {0, 6}, // 0 is Sunday
+}
+
+type fieldBounds struct {
+	min, max int
+}
+
+// CronParseError describes a structural or semantic problem encountered
+// while parsing a cron expression, identifying the offending field where
+// possible.
+type CronParseError struct {
+	// Expression is the cron expression that failed to parse.
+	Expression string
+	// Field is the field that caused the error, or FieldUnknown if it
+	// could not be determined.
+	Field CronField
+	// Token is the raw value of Field, if Field is known and present in
+	// Expression; it is empty when the year field is missing.
+	Token string
+	// Position is the zero-based index of Field within Expression, or -1
+	// if it could not be determined.
+	Position int
+	// Err is the underlying error returned by the parser, or describing
+	// the recovered panic.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *CronParseError) Error() string {
+	if e.Field == FieldUnknown {
+		return fmt.Sprintf("cron expression %q: %v", e.Expression, e.Err)
+	}
+	return fmt.Sprintf("cron expression %q: invalid %s field %q (position %d): %v",
+		e.Expression, e.Field, e.Token, e.Position, e.Err)
+}
+
+// Unwrap returns the underlying parser error.
+func (e *CronParseError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateCronExpression parses expr using the same field layout
+// NewCronTriggerWithOptions would use, without constructing a CronTrigger.
+// It recovers from any panic raised by the underlying parser and returns a
+// *CronParseError describing the offending field when expr is invalid.
+func ValidateCronExpression(expr string, opts ...CronOption) error {
+	options := &cronOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	_, _, err := parseCronExpression(expr, options)
+	return err
+}
+
+// parseCronExpression parses expression according to options, returning the
+// resulting schedule and, when WithYear is set, the allowed years. Each
+// field is validated against its numeric bounds before being handed to the
+// underlying parser, so out-of-range values are reported against the
+// specific offending field rather than a generic parser error. Parser
+// panics are recovered and reported as a *CronParseError as well.
+func parseCronExpression(expression string, options *cronOptions) (schedule cron.Schedule, years []int, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &CronParseError{
+				Expression: expression,
+				Field:      FieldUnknown,
+				Position:   -1,
+				Err:        fmt.Errorf("parser panic: %v", r),
+			}
+		}
+	}()
+
+	cronExpr := expression
+	fieldOrder := cronFieldOrder(options)
+
+	if options.year {
+		fields := strings.Fields(expression)
+		if len(fields) != len(fieldOrder)+1 {
+			return nil, nil, &CronParseError{
+				Expression: expression,
+				Field:      FieldYear,
+				Position:   len(fieldOrder),
+				Err:        fmt.Errorf("expected %d fields, found %d", len(fieldOrder)+1, len(fields)),
+			}
+		}
+
+		parsedYears, yearErr := parseYearField(fields[len(fieldOrder)])
+		if yearErr != nil {
+			return nil, nil, &CronParseError{
+				Expression: expression,
+				Field:      FieldYear,
+				Token:      fields[len(fieldOrder)],
+				Position:   len(fieldOrder),
+				Err:        yearErr,
+			}
+		}
+		years = parsedYears
+		cronExpr = strings.Join(fields[:len(fieldOrder)], " ")
+	}
+
+	if fieldErr := validateCronFields(expression, cronExpr, fieldOrder); fieldErr != nil {
+		return nil, nil, fieldErr
+	}
+
+	parserFields := cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor
+	if options.seconds {
+		parserFields = cron.Second | parserFields
+	}
+	parser := cron.NewParser(parserFields)
+
+	schedule, parseErr := parser.Parse(cronExpr)
+	if parseErr != nil {
+		return nil, nil, &CronParseError{
+			Expression: expression,
+			Field:      FieldUnknown,
+			Position:   -1,
+			Err:        parseErr,
+		}
+	}
+
+	if len(years) > 0 {
+		schedule = &yearSchedule{schedule: schedule, years: years}
+	}
+
+	return schedule, years, nil
+}
+
+// cronFieldOrder returns the non-year fields expected by the parser
+// configured from options, in expression order.
+func cronFieldOrder(options *cronOptions) []CronField {
+	if options.seconds {
+		return []CronField{FieldSecond, FieldMinute, FieldHour, FieldDom, FieldMonth, FieldDow}
+	}
+	return []CronField{FieldMinute, FieldHour, FieldDom, FieldMonth, FieldDow}
+}
+
+// validateCronFields checks each field of cronExpr against its numeric
+// bounds, pinpointing the offending field, token and position when a value
+// is out of range. It is skipped (returning nil) when the field count
+// doesn't match order, leaving that structural problem for the underlying
+// parser to report.
+func validateCronFields(originalExpr, cronExpr string, order []CronField) error {
+	fields := strings.Fields(cronExpr)
+	if len(fields) != len(order) {
+		return nil
+	}
+
+	for i, field := range order {
+		bounds := cronFieldBounds[field]
+		if _, err := parseFieldSpec(fields[i], bounds); err != nil {
+			return &CronParseError{
+				Expression: originalExpr,
+				Field:      field,
+				Token:      fields[i],
+				Position:   i,
+				Err:        err,
+			}
+		}
+	}
+	return nil
+}
+
+// fieldSpec is the semantic value of a parsed cron field: either a
+// wildcard, or the sorted set of matching values, together with the step
+// used to produce them (0 if no step was given).
+type fieldSpec struct {
+	wildcard bool
+	step     int
+	values   []int
+}
+
+// parseFieldSpec interprets a cron field's lists, ranges and steps into a
+// fieldSpec, validating each numeric value against bounds. Named literals
+// (e.g. "MON", "JAN") are passed through unvalidated, since only the
+// underlying parser knows their mapping to numeric values.
+func parseFieldSpec(token string, bounds fieldBounds) (fieldSpec, error) {
+	if token == "*" || token == "?" {
+		return fieldSpec{wildcard: true}, nil
+	}
+
+	step := 0
+	values := make(map[int]bool)
+	for _, part := range strings.Split(token, ",") {
+		base := part
+		partStep := 1
+		if idx := strings.Index(part, "/"); idx >= 0 {
+			base = part[:idx]
+			s, err := strconv.Atoi(part[idx+1:])
+			if err != nil || s <= 0 {
+				return fieldSpec{}, fmt.Errorf("invalid step %q", part)
+			}
+			partStep = s
+			step = s
+		}
+
+		if hasAlpha(base) {
+			// Named literal; deferred to the underlying parser.
+			continue
+		}
+
+		start, end := bounds.min, bounds.max
+		switch {
+		case base == "*":
+		case strings.Contains(base, "-"):
+			rangeParts := strings.SplitN(base, "-", 2)
+			s, errStart := strconv.Atoi(rangeParts[0])
+			e, errEnd := strconv.Atoi(rangeParts[1])
+			if errStart != nil || errEnd != nil {
+				return fieldSpec{}, fmt.Errorf("invalid range %q", part)
+			}
+			start, end = s, e
+		default:
+			v, err := strconv.Atoi(base)
+			if err != nil {
+				return fieldSpec{}, fmt.Errorf("invalid value %q", part)
+			}
+			start, end = v, v
+		}
+
+		for v := start; v <= end; v += partStep {
+			if v < bounds.min || v > bounds.max {
+				return fieldSpec{}, fmt.Errorf("value %d out of range [%d, %d]", v, bounds.min, bounds.max)
+			}
+			values[v] = true
+		}
+	}
+
+	sorted := make([]int, 0, len(values))
+	for v := range values {
+		sorted = append(sorted, v)
+	}
+	sort.Ints(sorted)
+	return fieldSpec{step: step, values: sorted}, nil
+}
+
+func hasAlpha(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
+var monthNames = map[int]string{
+	1: "January", 2: "February", 3: "March", 4: "April",
+	5: "May", 6: "June", 7: "July", 8: "August",
+	9: "September", 10: "October", 11: "November", 12: "December",
+}
+
+var dowNames = map[int]string{
+	0: "Sunday", 1: "Monday", 2: "Tuesday", 3: "Wednesday",
+	4: "Thursday", 5: "Friday", 6: "Saturday",
+}
+
+// Humanize returns a short English description of the standard 5-field
+// cron expression expr, e.g. "every 2 hours on weekdays", by interpreting
+// the semantics of each parsed field rather than pattern-matching its raw
+// text.
+func Humanize(expr string) (string, error) {
+	if err := ValidateCronExpression(expr); err != nil {
+		return "", err
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return "", newIllegalArgumentError(
+			"Humanize supports standard 5-field cron expressions only: " + expr)
+	}
+
+	minute, err := parseFieldSpec(fields[0], cronFieldBounds[FieldMinute])
+	if err != nil {
+		return "", err
+	}
+	hour, err := parseFieldSpec(fields[1], cronFieldBounds[FieldHour])
+	if err != nil {
+		return "", err
+	}
+	dom, err := parseFieldSpec(fields[2], cronFieldBounds[FieldDom])
+	if err != nil {
+		return "", err
+	}
+	month, err := parseFieldSpec(fields[3], cronFieldBounds[FieldMonth])
+	if err != nil {
+		return "", err
+	}
+	dow, err := parseFieldSpec(fields[4], cronFieldBounds[FieldDow])
+	if err != nil {
+		return "", err
+	}
+
+	parts := []string{describeTimeOfDay(minute, hour)}
+	if desc := describeDom(dom); desc != "" {
+		parts = append(parts, desc)
+	}
+	if desc := describeMonth(month); desc != "" {
+		parts = append(parts, desc)
+	}
+	if desc := describeDow(dow); desc != "" {
+		parts = append(parts, desc)
+	}
+
+	return strings.Join(parts, " "), nil
+}
+
+func describeTimeOfDay(minute, hour fieldSpec) string {
+	// Step-minute within a bounded hour range (e.g. "*/5 9-17") is a common
+	// business-hours shape; special-case it before falling back to the full
+	// minute x hour cartesian enumeration below, which would otherwise
+	// produce an unreadably long list.
+	if minute.step > 0 && !hour.wildcard {
+		if start, end, ok := contiguousRange(hour.values); ok {
+			return fmt.Sprintf("every %d minutes from %02d:00 to %02d:59", minute.step, start, end)
+		}
+	}
+
+	switch {
+	case hour.wildcard && minute.wildcard:
+		return "every minute"
+	case hour.wildcard && minute.step > 0:
+		return fmt.Sprintf("every %d minutes", minute.step)
+	case hour.step > 0 && len(minute.values) == 1:
+		if minute.values[0] == 0 {
+			return fmt.Sprintf("every %d hours", hour.step)
+		}
+		return fmt.Sprintf("every %d hours at minute %d", hour.step, minute.values[0])
+	case !minute.wildcard && !hour.wildcard:
+		times := make([]string, 0, len(hour.values)*len(minute.values))
+		for _, h := range hour.values {
+			for _, m := range minute.values {
+				times = append(times, fmt.Sprintf("%02d:%02d", h, m))
+			}
+		}
+		sort.Strings(times)
+		return "at " + joinWithAnd(times)
+	default:
+		return fmt.Sprintf("at minute(s) %s of hour(s) %s", describeValues(minute), describeValues(hour))
+	}
+}
+
+// contiguousRange reports whether the sorted values form an unbroken run of
+// consecutive integers, returning its bounds.
+func contiguousRange(values []int) (start, end int, ok bool) {
+	if len(values) == 0 {
+		return 0, 0, false
+	}
+	start, end = values[0], values[0]
+	for i := 1; i < len(values); i++ {
+		if values[i] != values[i-1]+1 {
+			return 0, 0, false
+		}
+		end = values[i]
+	}
+	return start, end, true
+}
+
+func describeDom(dom fieldSpec) string {
+	if dom.wildcard {
+		return ""
+	}
+	if dom.step > 0 {
+		return fmt.Sprintf("every %d days", dom.step)
+	}
+	noun := "day"
+	if len(dom.values) > 1 {
+		noun = "days"
+	}
+	return fmt.Sprintf("on %s %s of the month", noun, joinWithAnd(intsToStrings(dom.values)))
+}
+
+func describeMonth(month fieldSpec) string {
+	if month.wildcard {
+		return ""
+	}
+	if month.step > 0 {
+		return fmt.Sprintf("every %d months", month.step)
+	}
+	names := make([]string, len(month.values))
+	for i, m := range month.values {
+		names[i] = monthNames[m]
+	}
+	return "in " + joinWithAnd(names)
+}
+
+func describeDow(dow fieldSpec) string {
+	if dow.wildcard {
+		return ""
+	}
+
+	normalized := normalizeDow(dow.values)
+	switch {
+	case equalInts(normalized, []int{1, 2, 3, 4, 5}):
+		return "on weekdays"
+	case equalInts(normalized, []int{0, 6}):
+		return "on weekends"
+	}
+
+	names := make([]string, len(normalized))
+	for i, d := range normalized {
+		names[i] = dowNames[d]
+	}
+	return "on " + joinWithAnd(names)
+}
+
+// normalizeDow folds the day-of-week alias 7 (Sunday) into 0 and
+// deduplicates the result.
+func normalizeDow(values []int) []int {
+	set := make(map[int]bool, len(values))
+	for _, v := range values {
+		if v == 7 {
+			v = 0
+		}
+		set[v] = true
+	}
+	out := make([]int, 0, len(set))
+	for v := range set {
+		out = append(out, v)
+	}
+	sort.Ints(out)
+	return out
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// describeValues renders a fieldSpec's values as a comma-separated list,
+// used by the generic describeTimeOfDay fallback.
+func describeValues(spec fieldSpec) string {
+	if spec.wildcard {
+		return "*"
+	}
+	return strings.Join(intsToStrings(spec.values), ",")
+}
+
+func intsToStrings(values []int) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = strconv.Itoa(v)
+	}
+	return out
+}
+
+// joinWithAnd joins items with commas, using "and" before the final item.
+func joinWithAnd(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	default:
+		return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+	}
+}
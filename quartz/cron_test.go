@@ -1,6 +1,7 @@
 package quartz_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -348,3 +349,213 @@ const readDateLayout = "Mon Jan 2 15:04:05 2006"
 func formatTime(t int64, loc *time.Location) string {
 	return time.UnixMilli(t).In(loc).Format(readDateLayout)
 }
+
+func TestCronTriggerPreview(t *testing.T) {
+	t.Parallel()
+
+	trigger, err := quartz.NewCronTrigger("0 */2 * * *") // every 2 hours
+	assert.IsNil(t, err)
+
+	prev := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC).UnixMilli()
+	times, err := trigger.Preview(prev, 3)
+	assert.IsNil(t, err)
+
+	expected := []string{
+		"Mon Jan 1 14:00:00 2024",
+		"Mon Jan 1 16:00:00 2024",
+		"Mon Jan 1 18:00:00 2024",
+	}
+	formatted := quartz.FormatPreview(times, time.UTC, readDateLayout)
+	for i, want := range expected {
+		assert.Equal(t, formatted[i], want)
+	}
+}
+
+func TestCronTriggerPreviewPartial(t *testing.T) {
+	t.Parallel()
+
+	// Bounded to a single fire time; Preview must stop early rather than
+	// erroring once the schedule runs out.
+	endAt := time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC)
+	trigger, err := quartz.NewCronTriggerWithOptions("0 */2 * * *", quartz.WithEndAt(endAt))
+	assert.IsNil(t, err)
+
+	prev := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC).UnixMilli()
+	times, err := trigger.Preview(prev, 5)
+	assert.IsNil(t, err)
+	assert.Equal(t, len(times), 1)
+}
+
+func TestValidateCronExpressionLocatesOffendingField(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr  string
+		field quartz.CronField
+		token string
+	}{
+		{"99 * * * *", quartz.FieldMinute, "99"},
+		{"* 99 * * *", quartz.FieldHour, "99"},
+		{"* * 99 * *", quartz.FieldDom, "99"},
+		{"* * * 99 *", quartz.FieldMonth, "99"},
+		{"* * * * 99", quartz.FieldDow, "99"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.expr, func(t *testing.T) {
+			t.Parallel()
+
+			err := quartz.ValidateCronExpression(test.expr)
+			if err == nil {
+				t.Fatalf("expected an error for %q", test.expr)
+			}
+
+			var parseErr *quartz.CronParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected a *quartz.CronParseError, got %T", err)
+			}
+			assert.Equal(t, parseErr.Field, test.field)
+			assert.Equal(t, parseErr.Token, test.token)
+		})
+	}
+}
+
+func TestValidateCronExpressionValid(t *testing.T) {
+	t.Parallel()
+
+	err := quartz.ValidateCronExpression("0 9 * * 1-5")
+	assert.IsNil(t, err)
+}
+
+func TestHumanize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expr     string
+		expected string
+	}{
+		{"0 */2 * * 1-5", "every 2 hours on weekdays"},
+		{"*/10 * * * *", "every 10 minutes"},
+		{"0 8 * * 0,6", "at 08:00 on weekends"},
+		{"0 9,17 * * *", "at 09:00 and 17:00"},
+		{"30 14 * * *", "at 14:30"},
+		{"*/5 9-17 * * 1-5", "every 5 minutes from 09:00 to 17:59 on weekdays"},
+	}
+
+	for _, tt := range tests {
+		test := tt
+		t.Run(test.expr, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := quartz.Humanize(test.expr)
+			assert.IsNil(t, err)
+			assert.Equal(t, result, test.expected)
+		})
+	}
+}
+
+func TestCronTriggerStartAt(t *testing.T) {
+	t.Parallel()
+
+	startAt := time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC)
+	trigger, err := quartz.NewCronTriggerWithOptions("0 * * * *", quartz.WithStartAt(startAt))
+	assert.IsNil(t, err)
+
+	// Without a start bound the next hourly fire after noon would be 13:00;
+	// with a start bound exactly on an hour boundary it fires right there.
+	prev := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC).UnixMilli()
+	next, err := trigger.NextFireTime(prev)
+	assert.IsNil(t, err)
+	assert.Equal(t, formatTime(next, time.UTC), "Mon Jan 1 13:00:00 2024")
+
+	// A prev already past startAt is unaffected by the bound.
+	prev = time.Date(2024, 1, 1, 15, 30, 0, 0, time.UTC).UnixMilli()
+	next, err = trigger.NextFireTime(prev)
+	assert.IsNil(t, err)
+	assert.Equal(t, formatTime(next, time.UTC), "Mon Jan 1 16:00:00 2024")
+}
+
+func TestCronTriggerEndAt(t *testing.T) {
+	t.Parallel()
+
+	endAt := time.Date(2024, 1, 1, 13, 30, 0, 0, time.UTC)
+	trigger, err := quartz.NewCronTriggerWithOptions("0 * * * *", quartz.WithEndAt(endAt))
+	assert.IsNil(t, err)
+
+	prev := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC).UnixMilli()
+	next, err := trigger.NextFireTime(prev)
+	assert.IsNil(t, err)
+	assert.Equal(t, formatTime(next, time.UTC), "Mon Jan 1 13:00:00 2024")
+
+	_, err = trigger.NextFireTime(next)
+	if err == nil {
+		t.Fatal("expected an error once the next fire time exceeds endAt")
+	}
+}
+
+func TestCronTriggerDescriptionBounds(t *testing.T) {
+	t.Parallel()
+
+	startAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endAt := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	trigger, err := quartz.NewCronTriggerWithOptions("0 * * * *",
+		quartz.WithStartAt(startAt), quartz.WithEndAt(endAt))
+	assert.IsNil(t, err)
+
+	expected := "CronTrigger::0 * * * *::UTC::" +
+		startAt.Format(time.RFC3339) + "::" + endAt.Format(time.RFC3339)
+	assert.Equal(t, trigger.Description(), expected)
+}
+
+func TestCronTriggerWithSeconds(t *testing.T) {
+	t.Parallel()
+
+	trigger, err := quartz.NewCronTriggerWithOptions("*/15 * * * * *", quartz.WithSeconds())
+	assert.IsNil(t, err)
+
+	prev := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC).UnixMilli()
+	next, err := trigger.NextFireTime(prev)
+	assert.IsNil(t, err)
+	assert.Equal(t, time.UnixMilli(next).In(time.UTC).Second(), 15)
+}
+
+func TestCronTriggerYearBoundSearch(t *testing.T) {
+	t.Parallel()
+
+	// A daily schedule bounded to a single distant year requires far more
+	// than a single fire-by-fire step to reach; regression test for the
+	// yearSchedule search not jumping straight to the target year.
+	trigger, err := quartz.NewCronTriggerWithOptions("0 0 12 * * * 2030", quartz.WithYear())
+	assert.IsNil(t, err)
+
+	prev := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+	next, err := trigger.NextFireTime(prev)
+	assert.IsNil(t, err)
+	assert.Equal(t, formatTime(next, time.UTC), "Tue Jan 1 12:00:00 2030")
+}
+
+func TestCronTriggerYearBoundSearchExhausted(t *testing.T) {
+	t.Parallel()
+
+	// No fire time exists once the schedule has moved past every allowed
+	// year; NextFireTime must report that rather than hang or panic.
+	trigger, err := quartz.NewCronTriggerWithOptions("0 0 12 * * * 2020", quartz.WithYear())
+	assert.IsNil(t, err)
+
+	prev := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).UnixMilli()
+	_, err = trigger.NextFireTime(prev)
+	if err == nil {
+		t.Fatal("expected an error for a year bound entirely in the past")
+	}
+}
+
+func TestCronTriggerDescriptionYearMode(t *testing.T) {
+	t.Parallel()
+
+	// A wildcard year field still means WithYear mode was requested, and
+	// Description must say so even though the allowed-years list is empty.
+	trigger, err := quartz.NewCronTriggerWithOptions("0 0 12 * * * *", quartz.WithYear())
+	assert.IsNil(t, err)
+	assert.Equal(t, trigger.Description(), "CronTrigger::0 0 12 * * * *::UTC::years")
+}
@@ -0,0 +1,107 @@
+package quartz
+
+import (
+	"fmt"
+	"sync"
+)
+
+// EventKind identifies the kind of a Job lifecycle [Event] published on an
+// [EventBus].
+type EventKind int
+
+const (
+	// JobStarted is published when a Job's Execute method starts running.
+	JobStarted EventKind = iota
+	// JobSucceeded is published when Execute returns a nil error.
+	JobSucceeded
+	// JobFailed is published when Execute returns a non-nil error.
+	JobFailed
+	// JobTimedOut is published when Execute is aborted by its context deadline.
+	JobTimedOut
+	// JobSkipped is published when a scheduled fire time is dropped, e.g.
+	// by job.WithRetry while the Job is paused.
+	JobSkipped
+	// JobPaused is published when a Job is taken out of rotation, e.g. by
+	// job.WithRetry after its retry policy's MaxAttempts is exhausted.
+	JobPaused
+)
+
+// String returns the name of the EventKind.
+func (k EventKind) String() string {
+	switch k {
+	case JobStarted:
+		return "JobStarted"
+	case JobSucceeded:
+		return "JobSucceeded"
+	case JobFailed:
+		return "JobFailed"
+	case JobTimedOut:
+		return "JobTimedOut"
+	case JobSkipped:
+		return "JobSkipped"
+	case JobPaused:
+		return "JobPaused"
+	default:
+		return "Unknown"
+	}
+}
+
+// Event describes a Job lifecycle occurrence published on an [EventBus].
+type Event struct {
+	// Kind is the kind of occurrence this Event represents.
+	Kind EventKind
+	// JobDescription is the Description of the Job the event relates to.
+	JobDescription string
+	// Err is the error returned by the Job, if any. It is only set for
+	// JobFailed and JobTimedOut events.
+	Err error
+	// Time is the time the event was published, in Unix nanoseconds.
+	Time int64
+}
+
+// EventHandler handles an Event published on an [EventBus].
+type EventHandler func(Event)
+
+// EventBus is a publish/subscribe hub for Job lifecycle events, decoupling
+// publishers such as a Scheduler or Job from interested subscribers such as
+// metrics, retry or notification handlers.
+type EventBus struct {
+	mtx      sync.RWMutex
+	handlers map[EventKind][]EventHandler
+}
+
+// NewEventBus returns a new, empty [EventBus].
+func NewEventBus() *EventBus {
+	return &EventBus{
+		handlers: make(map[EventKind][]EventHandler),
+	}
+}
+
+// Subscribe registers handler to be invoked whenever an Event of the given
+// kind is published.
+func (b *EventBus) Subscribe(kind EventKind, handler EventHandler) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.handlers[kind] = append(b.handlers[kind], handler)
+}
+
+// Publish dispatches event to every handler subscribed to its kind. Each
+// handler runs in its own goroutine and a recovered panic is logged rather
+// than propagated, so a misbehaving subscriber cannot affect the publisher
+// or other subscribers.
+func (b *EventBus) Publish(event Event) {
+	b.mtx.RLock()
+	handlers := append([]EventHandler(nil), b.handlers[event.Kind]...)
+	b.mtx.RUnlock()
+
+	for _, handler := range handlers {
+		go func(h EventHandler) {
+			defer func() {
+				if r := recover(); r != nil {
+					fmt.Printf("EventBus handler panic: %v\n", r)
+				}
+			}()
+			h(event)
+		}(handler)
+	}
+}
@@ -0,0 +1,83 @@
+package quartz_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/reugn/go-quartz/internal/assert"
+	"github.com/reugn/go-quartz/quartz"
+)
+
+func TestEventBusPublishSubscribe(t *testing.T) {
+	t.Parallel()
+
+	bus := quartz.NewEventBus()
+
+	var mtx sync.Mutex
+	var received []quartz.Event
+	done := make(chan struct{}, 1)
+
+	bus.Subscribe(quartz.JobFailed, func(e quartz.Event) {
+		mtx.Lock()
+		received = append(received, e)
+		mtx.Unlock()
+		done <- struct{}{}
+	})
+
+	bus.Publish(quartz.Event{
+		Kind:           quartz.JobFailed,
+		JobDescription: "test job",
+		Time:           quartz.NowNano(),
+	})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler was not invoked")
+	}
+
+	mtx.Lock()
+	defer mtx.Unlock()
+	assert.Equal(t, len(received), 1)
+	assert.Equal(t, received[0].JobDescription, "test job")
+}
+
+func TestEventBusOnlyMatchingKindDispatched(t *testing.T) {
+	t.Parallel()
+
+	bus := quartz.NewEventBus()
+	called := make(chan struct{}, 1)
+
+	bus.Subscribe(quartz.JobSucceeded, func(quartz.Event) {
+		called <- struct{}{}
+	})
+
+	bus.Publish(quartz.Event{Kind: quartz.JobFailed})
+
+	select {
+	case <-called:
+		t.Fatal("handler for a different event kind must not be invoked")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestEventBusHandlerPanicRecovered(t *testing.T) {
+	t.Parallel()
+
+	bus := quartz.NewEventBus()
+	done := make(chan struct{}, 1)
+
+	bus.Subscribe(quartz.JobFailed, func(quartz.Event) {
+		defer func() { done <- struct{}{} }()
+		panic("boom")
+	})
+
+	bus.Publish(quartz.Event{Kind: quartz.JobFailed})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("panicking handler did not run to completion")
+	}
+}
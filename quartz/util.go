@@ -11,3 +11,13 @@ var Sep = "::"
 func NowNano() int64 {
 	return time.Now().UnixNano()
 }
+
+// FormatPreview formats a slice of fire times in millis, such as the one
+// returned by [CronTrigger.Preview], using loc and layout.
+func FormatPreview(times []int64, loc *time.Location, layout string) []string {
+	formatted := make([]string, len(times))
+	for i, t := range times {
+		formatted[i] = time.UnixMilli(t).In(loc).Format(layout)
+	}
+	return formatted
+}